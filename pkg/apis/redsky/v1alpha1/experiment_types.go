@@ -1,21 +1,107 @@
 package v1alpha1
 
 import (
+	"fmt"
+	"strconv"
+
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// ParameterType represents the allowable types of a parameter domain
+type ParameterType string
+
+const (
+	// Int parameters draw from a range of integers
+	ParameterTypeInt ParameterType = "int"
+	// Double parameters draw from a range of floating point numbers, represented as strings so the range can be exact
+	ParameterTypeDouble ParameterType = "double"
+	// Categorical parameters draw from a fixed list of string values
+	ParameterTypeCategorical ParameterType = "categorical"
+	// Boolean parameters draw from the fixed list of values "true" and "false"
+	ParameterTypeBoolean ParameterType = "boolean"
+)
+
+// FeasibleSpace describes the domain of a parameter. Min, Max, and Step are formatted as strings so that
+// non-integer domains (e.g. "double") can be represented exactly; List is only used for categorical parameters.
+type FeasibleSpace struct {
+	// The inclusive minimum value of the parameter
+	Min string `json:"min,omitempty"`
+	// The inclusive maximum value of the parameter
+	Max string `json:"max,omitempty"`
+	// The step size between feasible values, defaults to 1 for numeric types
+	Step string `json:"step,omitempty"`
+	// The feasible values of a categorical parameter
+	List []string `json:"list,omitempty"`
+}
+
 // Parameter represents the domain of a single component of the experiment search space
 type Parameter struct {
 	// The name of the parameter
 	Name string `json:"name"`
-	// The inclusive minimum value of the parameter
+	// The parameter type, one of: int|double|categorical|boolean, defaults to "int"
+	// +kubebuilder:validation:Enum=int;double;categorical;boolean
+	Type ParameterType `json:"type,omitempty"`
+	// The domain of the parameter; for "int" and "double" types this is a Min/Max (and optional Step), for
+	// "categorical" types this is a List of the allowed values, for "boolean" types this is ignored (the domain
+	// is always the list "false", "true")
+	FeasibleSpace FeasibleSpace `json:"feasibleSpace,omitempty"`
+
+	// Deprecated: use FeasibleSpace.Min instead. Min is migrated into FeasibleSpace.Min on read so existing
+	// Experiments using the original int-only range keep working.
 	Min int64 `json:"min,omitempty"`
-	// The inclusive maximum value of the parameter
+	// Deprecated: use FeasibleSpace.Max instead. Max is migrated into FeasibleSpace.Max on read so existing
+	// Experiments using the original int-only range keep working.
 	Max int64 `json:"max,omitempty"`
 }
 
+// Validate checks that the parameter's feasible space is consistent with its type, e.g. that a categorical
+// parameter supplies a list of values or that a numeric parameter supplies a min/max.
+func (p *Parameter) Validate() error {
+	switch p.effectiveType() {
+	case ParameterTypeCategorical:
+		if len(p.FeasibleSpace.List) == 0 {
+			return fmt.Errorf("parameter %q is categorical but specifies no feasible space list", p.Name)
+		}
+	case ParameterTypeBoolean:
+		// The feasible space is implicitly "false"/"true", nothing to validate
+	default:
+		if fs := p.GetFeasibleSpace(); fs.Min == "" && fs.Max == "" {
+			return fmt.Errorf("parameter %q must specify a feasible space min or max", p.Name)
+		}
+	}
+	return nil
+}
+
+// effectiveType returns the parameter type, defaulting to "int" for backward compatibility with Experiments
+// that only ever set the deprecated Min/Max fields.
+func (p *Parameter) effectiveType() ParameterType {
+	if p.Type != "" {
+		return p.Type
+	}
+	return ParameterTypeInt
+}
+
+// migrateLegacyRange returns a copy of fs with the deprecated int-only Min/Max fields folded in, so callers only
+// need to consult FeasibleSpace regardless of which representation an Experiment was written with. It does not
+// mutate the receiver: Parameter values are commonly read straight out of an informer's shared cache, and writing
+// the migration back into FeasibleSpace would corrupt that cache for every other reader.
+func (p *Parameter) migrateLegacyRange(fs FeasibleSpace) FeasibleSpace {
+	if fs.Min == "" && fs.Max == "" && (p.Min != 0 || p.Max != 0) {
+		fs.Min = strconv.FormatInt(p.Min, 10)
+		fs.Max = strconv.FormatInt(p.Max, 10)
+	}
+	return fs
+}
+
+// GetFeasibleSpace returns the parameter's feasible space, migrating the deprecated Min/Max fields first so
+// callers only ever need to consult the result. The receiver itself is left untouched.
+func (p *Parameter) GetFeasibleSpace() FeasibleSpace {
+	return p.migrateLegacyRange(p.FeasibleSpace)
+}
+
 // MetricType represents the allowable types of metrics
 type MetricType string
 
@@ -27,13 +113,155 @@ const (
 	MetricPrometheus = "prometheus"
 	// JSON path metrics fetch a JSON resource from the matched service. Queries are JSON path expression evaluated against the resource.
 	MetricJSONPath = "jsonpath"
-	// TODO "regex"?
+	// Service monitor metrics issue PromQL queries against the Prometheus instance that owns a referenced
+	// `monitoring.coreos.com/v1` ServiceMonitor, reusing its endpoint list and relabeling instead of a selector/port/path
+	MetricServiceMonitor = "servicemonitor"
+	// Pod monitor metrics are identical to service monitor metrics except the reference is to a PodMonitor
+	MetricPodMonitor = "podmonitor"
+	// Regex metrics fetch the response body from the matched service and apply a Go regexp with a named "value"
+	// capture group (and optionally a named "error" capture group, evaluated like ErrorQuery)
+	MetricRegex = "regex"
+	// Datadog metrics fetch a JSON resource from the Datadog API using the same query/selector/port/path fields
+	// as MetricJSONPath, but require an Auth reference for the API/application key headers
+	MetricDatadog = "datadog"
+)
+
+// ObjectiveType indicates whether an experiment is trying to minimize or maximize its objective metric
+type ObjectiveType string
+
+const (
+	// ObjectiveMinimize indicates the experiment is trying to minimize the objective metric
+	ObjectiveMinimize ObjectiveType = "minimize"
+	// ObjectiveMaximize indicates the experiment is trying to maximize the objective metric
+	ObjectiveMaximize ObjectiveType = "maximize"
 )
 
+// ObjectiveSpec defines the experiment's goal: which metric to optimize, which direction to optimize it in, and
+// (optionally) a goal value at which the experiment can stop early
+type ObjectiveSpec struct {
+	// The optimization direction, one of: minimize|maximize, defaults to "minimize"
+	// +kubebuilder:validation:Enum=minimize;maximize
+	Type ObjectiveType `json:"type,omitempty"`
+	// The name of the metric (from Metrics) used as the objective
+	ObjectiveMetricName string `json:"objectiveMetricName"`
+	// Additional metric names (from Metrics) that are collected but not optimized
+	AdditionalMetricNames []string `json:"additionalMetricNames,omitempty"`
+	// Goal is the objective metric value at which the experiment is considered complete; when unset the
+	// experiment runs until MaxTrialCount is reached
+	Goal *resource.Quantity `json:"goal,omitempty"`
+}
+
+// MetricAuth describes how to authenticate a request made while collecting a metric. Exactly one of the fields
+// should be set; it is shared across the prometheus, jsonpath, regex, and datadog metric types.
+type MetricAuth struct {
+	// Bearer token sourced from a Secret key, sent as an "Authorization: Bearer <token>" header
+	Bearer *MetricAuthBearer `json:"bearer,omitempty"`
+	// Basic auth username/password sourced from a Secret
+	Basic *MetricAuthBasic `json:"basic,omitempty"`
+	// Use the token from the controller's own in-cluster service account instead of a referenced Secret
+	ServiceAccount bool `json:"serviceAccount,omitempty"`
+}
+
+// MetricAuthBearer sources a bearer token from a Secret key
+type MetricAuthBearer struct {
+	// Reference to the Secret key containing the bearer token
+	TokenSecretKeyRef corev1.SecretKeySelector `json:"tokenSecretKeyRef"`
+}
+
+// MetricAuthBasic sources basic auth credentials from a Secret with "username" and "password" keys
+type MetricAuthBasic struct {
+	// Reference to the Secret containing "username" and "password" keys
+	SecretRef corev1.LocalObjectReference `json:"secretRef"`
+}
+
+// ConstraintParameter is a weighted reference to a parameter used as a term in a Product or Ratio constraint
+type ConstraintParameter struct {
+	// The name of the parameter term
+	Name string `json:"name"`
+	// The weight (or, for Product, the exponent) applied to the parameter's value
+	Weight resource.Quantity `json:"weight,omitempty"`
+}
+
+// OrderConstraint requires that one parameter's value never exceed another's, e.g. a minimum replica count
+// must not exceed a maximum replica count
+type OrderConstraint struct {
+	// The name of the parameter that must be less than or equal to UpperParameter
+	LowerParameter string `json:"lowerParameter"`
+	// The name of the parameter that must be greater than or equal to LowerParameter
+	UpperParameter string `json:"upperParameter"`
+}
+
+// SumConstraintParameter is a weighted reference to a parameter used as a term in a SumConstraint
+type SumConstraintParameter struct {
+	// The name of the parameter term
+	Name string `json:"name"`
+	// The weight applied to the parameter's value before summing
+	Weight resource.Quantity `json:"weight,omitempty"`
+}
+
+// SumConstraint bounds a weighted sum of parameter values, e.g. the total CPU requested across containers
+type SumConstraint struct {
+	// The bound the weighted sum must not cross
+	Bound resource.Quantity `json:"bound"`
+	// Whether Bound is an upper bound (sum must be <= Bound) or a lower bound (sum must be >= Bound)
+	IsUpperBound bool `json:"isUpperBound,omitempty"`
+	// The weighted parameters that make up the sum
+	Parameters []SumConstraintParameter `json:"parameters"`
+}
+
+// ProductConstraint bounds a weighted geometric product of parameter values, e.g. "cpu * replicas <= budget"
+type ProductConstraint struct {
+	// The bound the weighted product must not cross
+	Bound resource.Quantity `json:"bound"`
+	// Whether Bound is an upper bound (product must be <= Bound) or a lower bound (product must be >= Bound)
+	IsUpperBound bool `json:"isUpperBound,omitempty"`
+	// The weighted parameters that make up the product
+	Parameters []ConstraintParameter `json:"parameters"`
+}
+
+// RatioConstraint requires the ratio of a weighted numerator to a weighted denominator to lie within
+// [LowerBound, UpperBound], e.g. "memory/cpu" must stay within a configured range
+type RatioConstraint struct {
+	// The weighted parameters that make up the ratio's numerator
+	Numerator []ConstraintParameter `json:"numerator"`
+	// The weighted parameters that make up the ratio's denominator
+	Denominator []ConstraintParameter `json:"denominator"`
+	// The inclusive lower bound of the ratio
+	LowerBound resource.Quantity `json:"lowerBound"`
+	// The inclusive upper bound of the ratio
+	UpperBound resource.Quantity `json:"upperBound"`
+}
+
+// Constraint represents a relationship between parameters that must hold for a candidate assignment to be
+// valid; exactly one of the fields should be set
+type Constraint struct {
+	// The name of the constraint, used for diagnostics when a candidate assignment is rejected
+	Name string `json:"name,omitempty"`
+	// An ordering relationship between two parameters
+	Order *OrderConstraint `json:"order,omitempty"`
+	// A bound on a weighted sum of parameters
+	Sum *SumConstraint `json:"sum,omitempty"`
+	// A bound on a weighted geometric product of parameters
+	Product *ProductConstraint `json:"product,omitempty"`
+	// A bound on the ratio between two weighted groups of parameters
+	Ratio *RatioConstraint `json:"ratio,omitempty"`
+}
+
+// Optimization names a metric that is part of a multi-objective experiment's objective vector, along with the
+// direction it should be optimized in. Used instead of ObjectiveSpec when an experiment has more than one objective.
+type Optimization struct {
+	// The name of the metric (from Metrics) that is part of this optimization objective
+	Name string `json:"name"`
+	// The optimization direction for this metric, one of: minimize|maximize, defaults to "minimize"
+	// +kubebuilder:validation:Enum=minimize;maximize
+	Type ObjectiveType `json:"type,omitempty"`
+}
+
 // Metric represents an observable outcome from a trial run
 type Metric struct {
 	// The name of the metric
 	Name string `json:"name"`
+	// Deprecated: use ExperimentSpec.Objective.Type and ExperimentSpec.Objective.ObjectiveMetricName instead.
 	// Indicator that the goal of the experiment is to minimize the value of this metric
 	Minimize bool `json:"minimize,omitempty"`
 
@@ -49,7 +277,60 @@ type Metric struct {
 	// URL path component used to collect the metric value from an endpoint (used as a prefix for the Prometheus API)
 	Path string `json:"path,omitempty"`
 
-	// TODO ErrorQuery?
+	// Name of the ServiceMonitor or PodMonitor to resolve scrape targets from; required when Type is
+	// "servicemonitor" or "podmonitor", ignored otherwise. The Prometheus instance to query is discovered from
+	// the `monitoring.coreos.com/v1` Prometheus CR that owns the referenced monitor.
+	MonitorName string `json:"monitorName,omitempty"`
+	// Namespace of the referenced ServiceMonitor or PodMonitor, defaults to the trial namespace
+	MonitorNamespace string `json:"monitorNamespace,omitempty"`
+
+	// Auth configures credentials for requests made while collecting this metric
+	Auth *MetricAuth `json:"auth,omitempty"`
+
+	// ErrorQuery is evaluated identically to Query but its result is treated as an error indicator: a non-zero
+	// value fails the trial instead of recording a metric value. Used to catch a failed scrape or an SLI breach.
+	ErrorQuery string `json:"errorQuery,omitempty"`
+
+	// Sampling configures periodic collection of this metric while a trial's job is still running, for use as
+	// an intermediate metric by the experiment's EarlyStoppingPolicy
+	Sampling *MetricSampling `json:"sampling,omitempty"`
+}
+
+// MetricSampling configures periodic intermediate collection of a metric during a running trial, instead of
+// only once after ApproximateRuntime has elapsed
+type MetricSampling struct {
+	// Interval between intermediate collections of this metric
+	Interval metav1.Duration `json:"interval"`
+	// Collection type specific query used for intermediate collection, defaults to the metric's own Query
+	Query string `json:"query,omitempty"`
+}
+
+// EarlyStoppingPolicyType identifies the algorithm used to decide if a running trial should be stopped early
+type EarlyStoppingPolicyType string
+
+const (
+	// MedianStop terminates a trial whose intermediate metric is worse than the running median of completed
+	// trials' metrics at the same step
+	MedianStop EarlyStoppingPolicyType = "medianstop"
+	// SuccessiveHalving buckets trials into rungs by step and only promotes the top 1/Eta of each rung
+	SuccessiveHalving EarlyStoppingPolicyType = "successivehalving"
+)
+
+// EarlyStoppingPolicy configures mid-run pruning of unpromising trials based on an IntermediateMetric
+type EarlyStoppingPolicy struct {
+	// The early stopping algorithm to use, one of: medianstop|successivehalving
+	// +kubebuilder:validation:Enum=medianstop;successivehalving
+	Type EarlyStoppingPolicyType `json:"type"`
+	// MinTrials is the number of completed trials required before early stopping decisions are made
+	MinTrials int32 `json:"minTrials,omitempty"`
+	// StartStep is the first step at which a running trial is eligible to be stopped early
+	StartStep int32 `json:"startStep,omitempty"`
+	// CheckInterval is how often a running trial's intermediate metric is compared against completed trials
+	CheckInterval metav1.Duration `json:"checkInterval,omitempty"`
+	// Eta is the downsampling rate used by SuccessiveHalving (i.e. 1/Eta of each rung is promoted), ignored by MedianStop
+	Eta string `json:"eta,omitempty"`
+	// GraceChecks is the number of consecutive failing checks required before a trial is actually stopped
+	GraceChecks int32 `json:"graceChecks,omitempty"`
 }
 
 // PatchTemplate defines a target resource and a patch template to apply
@@ -72,14 +353,66 @@ type TrialTemplateSpec struct {
 	Spec              TrialSpec `json:"spec"`
 }
 
+// AlgorithmName identifies a pluggable suggestion algorithm used to generate trial parameter assignments
+type AlgorithmName string
+
+const (
+	// AlgorithmRandom selects parameter assignments uniformly at random from the feasible space
+	AlgorithmRandom AlgorithmName = "random"
+	// AlgorithmGrid selects parameter assignments by exhaustively walking a discretized grid of the feasible space
+	AlgorithmGrid AlgorithmName = "grid"
+	// AlgorithmBayesianOptimization selects parameter assignments using a surrogate model of the objective
+	AlgorithmBayesianOptimization AlgorithmName = "bayesianoptimization"
+	// AlgorithmHyperband selects parameter assignments using successive halving over increasing budgets
+	AlgorithmHyperband AlgorithmName = "hyperband"
+	// AlgorithmTPE selects parameter assignments using a tree-structured Parzen estimator
+	AlgorithmTPE AlgorithmName = "tpe"
+)
+
+// AlgorithmSetting is a name/value pair used to configure a suggestion algorithm, e.g. "n_initial_points" or "acq_func"
+type AlgorithmSetting struct {
+	// The name of the algorithm setting
+	Name string `json:"name"`
+	// The value of the algorithm setting
+	Value string `json:"value"`
+}
+
+// AlgorithmSpec names the suggestion algorithm used to generate trial parameter assignments for an experiment
+type AlgorithmSpec struct {
+	// The name of the suggestion algorithm to use, defaults to "random"
+	// +kubebuilder:validation:Enum=random;grid;bayesianoptimization;hyperband;tpe
+	Name AlgorithmName `json:"name,omitempty"`
+	// Algorithm specific settings, e.g. "n_initial_points" for bayesianoptimization
+	AlgorithmSettings []AlgorithmSetting `json:"algorithmSettings,omitempty"`
+}
+
 // ExperimentSpec defines the desired state of Experiment
 type ExperimentSpec struct {
 	// Replicas is the number of trials to execute concurrently, defaults to 1
 	Replicas *int32 `json:"replicas,omitempty"`
 	// Parallelism is the total number of expected replicas across all clusters, defaults to the replica count
 	Parallelism *int32 `json:"parallelism,omitempty"`
+	// MaxTrialCount is the maximum total number of trials to run, after which the experiment is considered complete
+	MaxTrialCount *int32 `json:"maxTrialCount,omitempty"`
+	// MaxFailedTrialCount is the maximum number of failed trials to tolerate before the experiment itself is considered failed
+	MaxFailedTrialCount *int32 `json:"maxFailedTrialCount,omitempty"`
+	// Algorithm names the suggestion algorithm used to generate trial parameter assignments, defaults to "random"
+	Algorithm *AlgorithmSpec `json:"algorithm,omitempty"`
+	// Objective defines the goal of the experiment in terms of a single metric to optimize
+	Objective *ObjectiveSpec `json:"objective,omitempty"`
+	// Optimization lists the metrics (and their directions) that make up a multi-objective experiment's
+	// objective vector; when more than one entry is present the experiment tracks a Pareto front instead of a
+	// single BestTrial
+	Optimization []Optimization `json:"optimization,omitempty"`
+	// EarlyStopping configures mid-run pruning of trials whose intermediate metrics are dominated by prior completions
+	EarlyStopping *EarlyStoppingPolicy `json:"earlyStopping,omitempty"`
+	// Paused stops the experiment from creating new trials while leaving in-flight trials to run to completion
+	Paused bool `json:"paused,omitempty"`
 	// Parameters defines the search space for the experiment
 	Parameters []Parameter `json:"parameters,omitempty"`
+	// Constraints restrict the combinations of parameter values that can be used to create a trial; candidate
+	// assignments that violate a constraint are rejected (or repaired, where possible) before a trial is created
+	Constraints []Constraint `json:"constraints,omitempty"`
 	// Metrics defines the outcomes for the experiment
 	Metrics []Metric `json:"metrics,omitempty"`
 	// Patches is a sequence of templates written against the experiment parameters that will be used to put the
@@ -97,9 +430,137 @@ type ExperimentSpec struct {
 	Template TrialTemplateSpec `json:"template"`
 }
 
+// TrialReference is a reference to a Trial, scoped to the namespace of the referencing Experiment
+type TrialReference struct {
+	// The name of the referenced trial
+	Name string `json:"name"`
+}
+
+// ExperimentConditionType represents the possible observable conditions for an experiment
+type ExperimentConditionType string
+
+const (
+	// Condition that indicates the experiment is actively creating and observing trials
+	ExperimentProgressing ExperimentConditionType = "Progressing"
+	// Condition that indicates the experiment met its objective goal (or exhausted MaxTrialCount) and stopped creating trials
+	ExperimentComplete ExperimentConditionType = "Complete"
+	// Condition that indicates the experiment stopped because MaxFailedTrialCount was reached
+	ExperimentFailed ExperimentConditionType = "Failed"
+	// Condition that indicates the experiment has made no progress (no new trials observed) for an extended period
+	ExperimentStalled ExperimentConditionType = "Stalled"
+	// Condition that indicates the experiment is paused and not creating new trials
+	ExperimentPaused ExperimentConditionType = "Paused"
+)
+
+// ExperimentCondition represents an observed condition of an experiment
+type ExperimentCondition struct {
+	// The condition type, e.g. "Complete"
+	Type ExperimentConditionType `json:"type"`
+	// The status of the condition, one of "True", "False", or "Unknown"
+	Status corev1.ConditionStatus `json:"status"`
+	// The last known time the condition was checked
+	LastProbeTime metav1.Time `json:"lastProbeTime"`
+	// The time at which the condition last changed status
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+	// A reason code describing why the condition occurred
+	Reason string `json:"reason,omitempty"`
+	// A human readable message describing the transition
+	Message string `json:"message,omitempty"`
+}
+
 // ExperimentStatus defines the observed state of Experiment
 type ExperimentStatus struct {
-	// TODO Number of trials: Active, Succeeded, Failed int32 (this is difficult, if not impossible, because we delete trials)
+	// Active is the number of trials currently running
+	Active int32 `json:"active,omitempty"`
+	// Succeeded is the number of trials that completed successfully
+	Succeeded int32 `json:"succeeded,omitempty"`
+	// Failed is the number of trials that completed unsuccessfully
+	Failed int32 `json:"failed,omitempty"`
+	// TrialsTotal is the total number of trials created for this experiment, including ones that have since been
+	// garbage collected; it is updated atomically on trial state transitions so the outcome is preserved even
+	// after the Trial object itself is deleted
+	TrialsTotal int32 `json:"trialsTotal,omitempty"`
+
+	// StartTime is the time the first trial was created
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time the experiment was marked complete or failed
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Conditions is the set of observed conditions for the experiment
+	Conditions []ExperimentCondition `json:"conditions,omitempty"`
+
+	// BestTrial references the trial that produced the best observed objective value so far, used when the
+	// experiment's goal is met so the reconciler can stop creating new trials while in-flight ones finish
+	BestTrial *TrialReference `json:"bestTrial,omitempty"`
+	// BestTrialValue is the observed objective metric value for BestTrial, formatted as a string
+	BestTrialValue string `json:"bestTrialValue,omitempty"`
+
+	// ParetoFront is the current non-dominated set of trials for a multi-objective experiment (see
+	// ExperimentSpec.Optimization), recomputed whenever a trial completes
+	ParetoFront []ParetoPoint `json:"paretoFront,omitempty"`
+	// ParetoFrontSize is the number of points currently on ParetoFront, kept in sync for use as a printer column
+	ParetoFrontSize int32 `json:"paretoFrontSize,omitempty"`
+}
+
+// ParetoPoint is a single non-dominated point on a multi-objective experiment's Pareto front
+type ParetoPoint struct {
+	// TrialName is the trial that produced this point
+	TrialName string `json:"trialName"`
+	// Values are the observed objective metric values (one per ExperimentSpec.Optimization entry) for TrialName
+	Values []Value `json:"values"`
+}
+
+// Dominates returns true if p is weakly better than other on every objective value (matched by metric name)
+// and strictly better on at least one. Values missing from either point are ignored. Direction is determined
+// by matching the value's metric name against opt.Name; unmatched names default to minimize.
+func (p *ParetoPoint) Dominates(other *ParetoPoint, optimization []Optimization) bool {
+	direction := make(map[string]ObjectiveType, len(optimization))
+	for _, opt := range optimization {
+		direction[opt.Name] = opt.Type
+	}
+	otherValues := make(map[string]string, len(other.Values))
+	for _, v := range other.Values {
+		otherValues[v.Name] = v.Value
+	}
+
+	strictlyBetter := false
+	for _, v := range p.Values {
+		ov, ok := otherValues[v.Name]
+		if !ok {
+			continue
+		}
+		switch compareMetricValues(v.Value, ov, direction[v.Name]) {
+		case -1:
+			strictlyBetter = true
+		case 1:
+			return false
+		}
+	}
+	return strictlyBetter
+}
+
+// compareMetricValues compares two formatted metric values honoring the objective's direction, returning -1 if
+// a is better than b, 1 if b is better than a, and 0 if they are equal or cannot be parsed as numbers.
+func compareMetricValues(a, b string, direction ObjectiveType) int {
+	af, aerr := strconv.ParseFloat(a, 64)
+	bf, berr := strconv.ParseFloat(b, 64)
+	if aerr != nil || berr != nil {
+		return 0
+	}
+	switch {
+	case af == bf:
+		return 0
+	case direction == ObjectiveMaximize:
+		if af > bf {
+			return -1
+		}
+		return 1
+	default:
+		if af < bf {
+			return -1
+		}
+		return 1
+	}
 }
 
 // +genclient
@@ -107,6 +568,12 @@ type ExperimentStatus struct {
 
 // Experiment is the Schema for the experiments API
 // +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="Trials",type="integer",JSONPath=".status.trialsTotal",description="Total number of trials"
+// +kubebuilder:printcolumn:name="Succeeded",type="integer",JSONPath=".status.succeeded",description="Number of trials that completed successfully"
+// +kubebuilder:printcolumn:name="Best",type="string",JSONPath=".status.bestTrialValue",description="Best observed objective value"
+// +kubebuilder:printcolumn:name="Front",type="integer",JSONPath=".status.paretoFrontSize",description="Number of points on the Pareto front"
 type Experiment struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -0,0 +1,117 @@
+package v1alpha1
+
+import "testing"
+
+func TestCompareMetricValues(t *testing.T) {
+	cases := []struct {
+		name      string
+		a, b      string
+		direction ObjectiveType
+		want      int
+	}{
+		{name: "minimize a better", a: "1", b: "2", direction: ObjectiveMinimize, want: -1},
+		{name: "minimize b better", a: "2", b: "1", direction: ObjectiveMinimize, want: 1},
+		{name: "minimize tie", a: "1", b: "1", direction: ObjectiveMinimize, want: 0},
+		{name: "maximize a better", a: "2", b: "1", direction: ObjectiveMaximize, want: -1},
+		{name: "maximize b better", a: "1", b: "2", direction: ObjectiveMaximize, want: 1},
+		{name: "maximize tie", a: "2", b: "2", direction: ObjectiveMaximize, want: 0},
+		{name: "default direction is minimize", a: "1", b: "2", direction: "", want: -1},
+		{name: "unparsable a", a: "n/a", b: "1", direction: ObjectiveMinimize, want: 0},
+		{name: "unparsable b", a: "1", b: "n/a", direction: ObjectiveMinimize, want: 0},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := compareMetricValues(c.a, c.b, c.direction); got != c.want {
+				t.Errorf("compareMetricValues(%q, %q, %q) = %d, want %d", c.a, c.b, c.direction, got, c.want)
+			}
+		})
+	}
+}
+
+func TestParetoPointDominates(t *testing.T) {
+	minimizeCost := []Optimization{{Name: "cost", Type: ObjectiveMinimize}}
+
+	cases := []struct {
+		name         string
+		p, other     ParetoPoint
+		optimization []Optimization
+		want         bool
+	}{
+		{
+			name:         "strictly better on the only objective",
+			p:            ParetoPoint{Values: []Value{{Name: "cost", Value: "1"}}},
+			other:        ParetoPoint{Values: []Value{{Name: "cost", Value: "2"}}},
+			optimization: minimizeCost,
+			want:         true,
+		},
+		{
+			name:         "strictly worse on the only objective",
+			p:            ParetoPoint{Values: []Value{{Name: "cost", Value: "2"}}},
+			other:        ParetoPoint{Values: []Value{{Name: "cost", Value: "1"}}},
+			optimization: minimizeCost,
+			want:         false,
+		},
+		{
+			name:         "equal values do not dominate",
+			p:            ParetoPoint{Values: []Value{{Name: "cost", Value: "1"}}},
+			other:        ParetoPoint{Values: []Value{{Name: "cost", Value: "1"}}},
+			optimization: minimizeCost,
+			want:         false,
+		},
+		{
+			name: "weakly better on one, strictly better on another",
+			p: ParetoPoint{Values: []Value{
+				{Name: "cost", Value: "1"},
+				{Name: "latency", Value: "5"},
+			}},
+			other: ParetoPoint{Values: []Value{
+				{Name: "cost", Value: "1"},
+				{Name: "latency", Value: "10"},
+			}},
+			optimization: []Optimization{
+				{Name: "cost", Type: ObjectiveMinimize},
+				{Name: "latency", Type: ObjectiveMinimize},
+			},
+			want: true,
+		},
+		{
+			name: "strictly better on one, strictly worse on another",
+			p: ParetoPoint{Values: []Value{
+				{Name: "cost", Value: "1"},
+				{Name: "latency", Value: "20"},
+			}},
+			other: ParetoPoint{Values: []Value{
+				{Name: "cost", Value: "2"},
+				{Name: "latency", Value: "10"},
+			}},
+			optimization: []Optimization{
+				{Name: "cost", Type: ObjectiveMinimize},
+				{Name: "latency", Type: ObjectiveMinimize},
+			},
+			want: false,
+		},
+		{
+			name:         "values missing from other are ignored",
+			p:            ParetoPoint{Values: []Value{{Name: "cost", Value: "1"}, {Name: "unmatched", Value: "1"}}},
+			other:        ParetoPoint{Values: []Value{{Name: "cost", Value: "2"}}},
+			optimization: minimizeCost,
+			want:         true,
+		},
+		{
+			name:         "unmatched metric name defaults to minimize",
+			p:            ParetoPoint{Values: []Value{{Name: "cost", Value: "1"}}},
+			other:        ParetoPoint{Values: []Value{{Name: "cost", Value: "2"}}},
+			optimization: nil,
+			want:         true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := c.p.Dominates(&c.other, c.optimization); got != c.want {
+				t.Errorf("Dominates() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
@@ -37,8 +37,9 @@ type ParameterSelector struct {
 
 // HelmValueFromSource represents a source of a values mapping
 type HelmValuesFromSource struct {
-	ConfigMap *ConfigMapHelmValuesFromSource `json:"configMap"`
-	// TODO Secret support?
+	ConfigMap *ConfigMapHelmValuesFromSource `json:"configMap,omitempty"`
+	// Secret is preferred over ConfigMap for values containing credentials or other sensitive data
+	Secret *SecretHelmValuesFromSource `json:"secret,omitempty"`
 }
 
 // ConfigMapHelmValuesFromSource is a reference to a ConfigMap that contains "*values.yaml" keys
@@ -47,6 +48,108 @@ type ConfigMapHelmValuesFromSource struct {
 	corev1.LocalObjectReference `json:",inline"`
 }
 
+// SecretHelmValuesFromSource is a reference to a Secret that contains "*values.yaml" keys
+type SecretHelmValuesFromSource struct {
+	corev1.LocalObjectReference `json:",inline"`
+}
+
+// HelmChartSource is a first-class reference to a chart in a repository, modeled on Flux's
+// CrossNamespaceObjectReference so the same repository/auth conventions apply
+type HelmChartSource struct {
+	// The chart repository URL; may be HTTP(S), "oci://" for an OCI registry, or a Git URL
+	Repository string `json:"repository"`
+	// The chart name within the repository, ignored for Git repositories where Version selects a ref
+	Chart string `json:"chart,omitempty"`
+	// The chart version (or, for a Git repository, the ref) to install, defaults to the latest version
+	Version string `json:"version,omitempty"`
+	// How often to check the repository for updates, mirrors Flux's HelmRepository/OCIRepository interval
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// Reference to a Secret with repository pull credentials, TLS certificates, or known_hosts, used for OCI
+	// registry login or Git authentication as appropriate for the repository scheme
+	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
+}
+
+// KustomizeSubstitution maps a trial parameter onto a field path within the kustomize build output
+type KustomizeSubstitution struct {
+	// The name of the trial parameter to substitute
+	ParameterName string `json:"parameterName"`
+	// The field path (e.g. a JSON path expression) within the rendered resource to set to the parameter's value
+	FieldPath string `json:"fieldPath"`
+}
+
+// ConfigMapKustomizeSource is a reference to a ConfigMap containing a kustomization directory layout
+type ConfigMapKustomizeSource struct {
+	corev1.LocalObjectReference `json:",inline"`
+}
+
+// GitKustomizeSource is a reference to a kustomization directory within a Git repository
+type GitKustomizeSource struct {
+	// The Git repository URL
+	Repository string `json:"repository"`
+	// The Git ref (branch, tag, or commit) to check out, defaults to the repository's default branch
+	Ref string `json:"ref,omitempty"`
+	// The path within the repository to the kustomization directory, defaults to the repository root
+	Path string `json:"path,omitempty"`
+}
+
+// OCIKustomizeSource is a reference to a kustomization directory packaged as an OCI artifact
+type OCIKustomizeSource struct {
+	// The OCI repository reference, e.g. "ghcr.io/example/overlay"
+	Repository string `json:"repository"`
+	// The OCI tag or digest to pull, defaults to "latest"
+	Ref string `json:"ref,omitempty"`
+	// The path within the artifact to the kustomization directory, defaults to the artifact root
+	Path string `json:"path,omitempty"`
+}
+
+// KustomizeImageOverride overrides an image reference in the kustomize build output, equivalent to a kustomize "images" entry
+type KustomizeImageOverride struct {
+	// The image name to match in the rendered resources
+	Name string `json:"name"`
+	// The replacement image name, defaults to Name
+	NewName string `json:"newName,omitempty"`
+	// The replacement image tag
+	NewTag string `json:"newTag,omitempty"`
+}
+
+// KustomizeValuesFromSource represents a source for a kustomization directory
+type KustomizeValuesFromSource struct {
+	// Source a kustomization from a ConfigMap
+	ConfigMap *ConfigMapKustomizeSource `json:"configMap,omitempty"`
+	// Source a kustomization from a Git repository
+	Git *GitKustomizeSource `json:"git,omitempty"`
+	// Source a kustomization packaged as an OCI artifact
+	OCI *OCIKustomizeSource `json:"oci,omitempty"`
+	// Image overrides applied to the kustomize build output
+	Images []KustomizeImageOverride `json:"images,omitempty"`
+}
+
+// SetupTaskMode selects how a SetupTask's Helm release is materialized and reconciled
+type SetupTaskMode string
+
+const (
+	// SetupTaskModeJob runs Helm in-process from a setup task Job, the original behavior
+	SetupTaskModeJob SetupTaskMode = "Job"
+	// SetupTaskModeFluxHelmRelease materializes the setup as a `helm.toolkit.fluxcd.io` HelmRelease (plus a
+	// matching HelmRepository/OCIRepository) owned by the Trial, and drives TrialSetupReady/TrialSetupFailed off
+	// the HelmRelease's own conditions instead of Job completion
+	SetupTaskModeFluxHelmRelease SetupTaskMode = "FluxHelmRelease"
+)
+
+// PostRenderer mutates the resources produced by "helm template" before they are applied, letting an experiment
+// tune a chart it cannot otherwise parameterize (e.g. inject the trial's parameter assignments into a field the
+// chart doesn't expose as a value, add a sidecar, or rename a resource) without forking it
+type PostRenderer struct {
+	// A strategic-merge/JSON patch selected by the target's GVK+name, applied to the matching rendered manifest
+	Patch *PatchOperation `json:"patch,omitempty"`
+	// A kustomize overlay applied to the full set of rendered manifests
+	Kustomize *KustomizeValuesFromSource `json:"kustomize,omitempty"`
+	// Force every rendered manifest into this namespace
+	Namespace string `json:"namespace,omitempty"`
+	// Labels injected into every rendered manifest
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
 // SetupTask represents the configuration necessary to apply application state to the cluster
 // prior to each trial run and remove that state after the run concludes
 type SetupTask struct {
@@ -54,18 +157,79 @@ type SetupTask struct {
 	Name string `json:"name"`
 	// Override the default image used for performing setup tasks
 	Image string `json:"image,omitempty"`
+	// Mode selects how a Helm based setup task is reconciled, one of: Job|FluxHelmRelease, defaults to "Job"
+	// +kubebuilder:validation:Enum=Job;FluxHelmRelease
+	Mode SetupTaskMode `json:"mode,omitempty"`
 	// Flag to indicate the creation part of the task can be skipped
 	SkipCreate bool `json:"skipCreate,omitempty"`
 	// Flag to indicate the deletion part of the task can be skipped
 	SkipDelete bool `json:"skipDelete,omitempty"`
 	// Volume mounts for the setup task
 	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
-	// The Helm chart reference to release as part of this task
+	// Deprecated: use HelmChartRef instead. The Helm chart reference to release as part of this task
 	HelmChart string `json:"helmChart,omitempty"`
+	// HelmChartRef is a first-class reference to the chart repository (HTTP, OCI, or Git) to release as part of
+	// this task, including pull credentials; takes precedence over HelmChart when both are set
+	HelmChartRef *HelmChartSource `json:"helmChartRef,omitempty"`
 	// The Helm values to set, ignored unless helmChart is also set
 	HelmValues []HelmValue `json:"helmValues,omitempty"`
 	// The Helm values, ignored unless helmChart is also set
 	HelmValuesFrom []HelmValuesFromSource `json:"helmValuesFrom,omitempty"`
+	// PostRenderers run, in order, after "helm template" but before apply, ignored unless helmChart or
+	// helmChartRef is also set
+	PostRenderers []PostRenderer `json:"postRenderers,omitempty"`
+
+	// The kustomization to build as an alternative to helmChart; the rendered resources are applied/pruned
+	// directly (no Helm release is created)
+	Kustomize *KustomizeValuesFromSource `json:"kustomize,omitempty"`
+	// Parameter substitutions applied to the kustomize build output, ignored unless kustomize is also set
+	KustomizeSubstitutions []KustomizeSubstitution `json:"kustomizeSubstitutions,omitempty"`
+	// Inline strategic-merge/JSON6902 patches applied to the kustomize build output before apply, ignored unless
+	// kustomize is also set
+	KustomizePatches []PatchOperation `json:"kustomizePatches,omitempty"`
+
+	// Inventory names a ConfigMap the setup-tools image records applied resource references into, so the delete
+	// phase can remove exactly what was created rather than re-running kustomize build against a possibly
+	// changed source
+	Inventory *corev1.LocalObjectReference `json:"inventory,omitempty"`
+
+	// Remediation configures the retry/rollback behavior applied once the setup task's own attempt counter is
+	// exhausted; KeepHistory and CleanupOnFail are ignored unless helmChart or helmChartRef is also set, the
+	// remaining fields apply to Kustomize based setup tasks as well
+	Remediation *RemediationStrategy `json:"remediation,omitempty"`
+}
+
+// OnFailureAction determines what the reconciler does once RemediationStrategy.Retries is exhausted
+type OnFailureAction string
+
+const (
+	// Leave the trial in a Failed state; this is the default
+	OnFailureFail OnFailureAction = "Fail"
+	// Restore the pre-patch object snapshot (or invoke the Helm rollback for Helm based setup tasks), then fail
+	// the trial
+	OnFailureRollback OnFailureAction = "Rollback"
+	// Proceed as if the setup task or patch had succeeded
+	OnFailureIgnore OnFailureAction = "Ignore"
+)
+
+// RemediationStrategy configures how the reconciler responds to a failed setup task or patch operation, giving
+// users deterministic failure semantics instead of silent retry-until-zero
+type RemediationStrategy struct {
+	// The number of times to retry before applying OnFailure, defaults to 0 (no retries)
+	Retries int `json:"retries,omitempty"`
+	// The initial delay between retries, doubled (with jitter) on each subsequent attempt, defaults to 1s
+	BackoffBase *metav1.Duration `json:"backoffBase,omitempty"`
+	// The upper bound on the exponential backoff delay
+	BackoffMax *metav1.Duration `json:"backoffMax,omitempty"`
+	// The action to take once Retries is exhausted, one of: Fail|Rollback|Ignore, defaults to "Fail"
+	// +kubebuilder:validation:Enum=Fail;Rollback;Ignore
+	OnFailure OnFailureAction `json:"onFailure,omitempty"`
+	// KeepHistory bounds the number of Helm releases retained after a rollback, mirrors Flux's install/upgrade
+	// remediation, ignored for non-Helm setup tasks
+	KeepHistory int `json:"keepHistory,omitempty"`
+	// CleanupOnFail uninstalls the Helm release if OnFailure is Rollback and no prior release exists to roll
+	// back to, mirrors Flux's install/upgrade remediation, ignored for non-Helm setup tasks
+	CleanupOnFail bool `json:"cleanupOnFail,omitempty"`
 }
 
 // PatchOperation represents a patch used to prepare the cluster for a trial run, includes the evaluated
@@ -80,13 +244,18 @@ type PatchOperation struct {
 	// The number of remaining attempts to apply the patch, will be automatically set
 	// to zero if the patch is successfully applied
 	AttemptsRemaining int `json:"attemptsRemaining,omitempty"`
+	// Snapshot is the target object state captured immediately before Data was applied, used to restore the
+	// object when RemediationStrategy.OnFailure is Rollback
+	Snapshot []byte `json:"snapshot,omitempty"`
 }
 
 // Assignment represents an individual name/value pair. Assignment names must correspond to parameter
-// names on the associated experiment.
+// names on the associated experiment. Value is formatted as a string (like FeasibleSpace.Min/Max) rather than
+// intstr.IntOrString so that categorical and boolean assignments and int/double assignments can share the field
+// without truncating an int parameter's value to intstr's int32 IntVal.
 type Assignment struct {
 	Name  string `json:"name"`
-	Value int64  `json:"value"`
+	Value string `json:"value"`
 }
 
 // Value represents an observed metric value after a trial run has completed successfully. Value names
@@ -104,6 +273,9 @@ type Value struct {
 	// TODO Initial value captured prior to job execution for local metrics?
 }
 
+// AlgorithmAnnotation records the name of the suggestion algorithm that produced a trial's parameter assignments
+const AlgorithmAnnotation = "redskyops.dev/algorithm"
+
 // TrialConditionType represents the possible observable conditions for a trial
 type TrialConditionType string
 
@@ -112,7 +284,30 @@ const (
 	TrialComplete TrialConditionType = "Complete"
 	// Condition that indicates a failed trial run when the status is "True". This condition SHOULD be omitted for other status values.
 	TrialFailed = "Failed"
-	// TODO TrialSetupCreate/Delete? TrialPatched? TrialStable?
+	// Condition that indicates the trial's setup tasks have finished applying and the workload can start. For
+	// SetupTaskModeFluxHelmRelease this is driven off the HelmRelease's "Released"/"TestSuccess" conditions
+	// instead of Job completion.
+	TrialSetupReady = "SetupReady"
+	// Condition that indicates one of the trial's setup tasks failed to apply. For SetupTaskModeFluxHelmRelease
+	// this is driven off the HelmRelease's "Remediated" condition instead of Job failure.
+	TrialSetupFailed = "SetupFailed"
+	// TODO TrialPatched? TrialStable?
+)
+
+// ReasonEarlyStopped is the TrialCondition reason recorded when the experiment's EarlyStoppingPolicy determines
+// a running trial is dominated by prior completions and terminates its Job ahead of ApproximateRuntime
+const ReasonEarlyStopped = "EarlyStopped"
+
+// Reason codes recorded against TrialSetupFailed/TrialFailed conditions identifying the RemediationStrategy
+// action taken for a failed setup task or patch operation
+const (
+	// ReasonRemediationRetry is recorded for each retry attempt while RemediationStrategy.Retries remains
+	ReasonRemediationRetry = "RemediationRetry"
+	// ReasonRemediationRollback is recorded when Retries is exhausted and OnFailure is Rollback, immediately
+	// before the pre-patch snapshot is restored (or the Helm release is rolled back)
+	ReasonRemediationRollback = "RemediationRollback"
+	// ReasonRemediationIgnored is recorded when Retries is exhausted and OnFailure is Ignore
+	ReasonRemediationIgnored = "RemediationIgnored"
 )
 
 // TrialCondition represents an observed condition of a trial
@@ -153,6 +348,9 @@ type TrialSpec struct {
 	Values []Value `json:"values,omitempty"`
 	// PatchOperations are the patches from the experiment evaluated in the context of this trial
 	PatchOperations []PatchOperation `json:"patchOperations,omitempty"`
+	// PatchRemediation configures retry/rollback behavior shared by all PatchOperations, ignored unless
+	// PatchOperations is also set
+	PatchRemediation *RemediationStrategy `json:"patchRemediation,omitempty"`
 
 	// Setup tasks that must run before the trial starts (and possibly after it ends)
 	SetupTasks []SetupTask `json:"setupTasks,omitempty"`
@@ -162,6 +360,18 @@ type TrialSpec struct {
 	SetupServiceAccountName string `json:"setupServiceAccountName,omitempty"`
 }
 
+// TrialCheckpoint captures enough of a running trial's progress that its workload can resume from the last
+// observed step instead of starting over after a Job pod restart
+type TrialCheckpoint struct {
+	// Step is the last step index the workload reported progress for
+	Step int32 `json:"step,omitempty"`
+	// IntermediateMetrics are the last sampled values for metrics configured with Metric.Sampling
+	IntermediateMetrics []Value `json:"intermediateMetrics,omitempty"`
+	// State is an opaque blob populated by the setup task sidecar; it is surfaced back to the workload through a
+	// downward API volume so it can resume from Step rather than starting over
+	State []byte `json:"state,omitempty"`
+}
+
 // TrialStatus defines the observed state of Trial
 type TrialStatus struct {
 	// Assignments is a string representation of the trial assignments for reporting purposes
@@ -174,6 +384,9 @@ type TrialStatus struct {
 	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
 	// Condition is the current state of the trial
 	Conditions []TrialCondition `json:"conditions,omitempty"`
+	// Checkpoint records the trial's last observed progress so a restarted workload can resume rather than
+	// starting over; only populated when a setup task's sidecar opts into checkpointing
+	Checkpoint *TrialCheckpoint `json:"checkpoint,omitempty"`
 }
 
 // +genclient
@@ -17,22 +17,47 @@ limitations under the License.
 package redskyapi
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"io"
 	"io/ioutil"
+	"math/rand"
 	"net/http"
 	"net/url"
+	"strconv"
+	"sync"
 	"time"
 )
 
+// Client is the interface used to make authenticated requests to the Red Sky API server.
 type Client interface {
-	URL(endpoint string) *url.URL
-	Do(context.Context, *http.Request) (*http.Response, []byte, error)
+	// URL resolves an API endpoint relative to the configured server address.
+	URL(endpoint string) (*url.URL, error)
+	// Do executes req and returns the response with its body left open for the caller to stream and close; the
+	// supplied context bounds both the round trip and the body read.
+	Do(ctx context.Context, req *http.Request) (*http.Response, io.ReadCloser, error)
+	// DoAndReadAll is a convenience wrapper around Do for callers that want the whole body buffered up front.
+	DoAndReadAll(ctx context.Context, req *http.Request) (*http.Response, []byte, error)
+}
+
+// RoundTripperChain composes http.RoundTripper middleware (e.g. OpenTelemetry or Prometheus instrumentation)
+// around a base transport, letting callers instrument requests without re-wrapping the OAuth2 transport.
+type RoundTripperChain []func(http.RoundTripper) http.RoundTripper
+
+// Then wraps base with each middleware in the chain, in order, returning the resulting http.RoundTripper.
+func (c RoundTripperChain) Then(base http.RoundTripper) http.RoundTripper {
+	rt := base
+	for i := len(c) - 1; i >= 0; i-- {
+		rt = c[i](rt)
+	}
+	return rt
 }
 
 // NewClient returns a new client for accessing Red Sky APIs; the supplied context is used for authentication/authorization
-// requests and the supplied transport (which may be nil in the case of the default transport) is used for all requests made
-// to the API server.
-func NewClient(cfg Config, ctx context.Context, transport http.RoundTripper) (Client, error) {
+// requests, the supplied transport (which may be nil in the case of the default transport) is used for all requests made
+// to the API server, and middleware is layered around the resulting OAuth2 transport.
+func NewClient(cfg Config, ctx context.Context, transport http.RoundTripper, middleware RoundTripperChain) (Client, error) {
 	var err error
 
 	hc := &httpClient{config: cfg}
@@ -43,52 +68,178 @@ func NewClient(cfg Config, ctx context.Context, transport http.RoundTripper) (Cl
 	if err != nil {
 		return nil, err
 	}
+	hc.client.Transport = middleware.Then(hc.client.Transport)
 
 	// Make sure that we can ignore the error from ExperimentsURL
-	_, err = cfg.ExperimentsURL("")
-	if err != nil {
+	if _, err = cfg.ExperimentsURL(""); err != nil {
 		return nil, err
 	}
 
 	return hc, nil
 }
 
+// maxRetries bounds the number of additional attempts made for a request that fails with a retryable status.
+const maxRetries = 3
+
+// cachedResponse is the conditional-request cache entry kept for a single GET endpoint URL.
+type cachedResponse struct {
+	etag string
+	body []byte
+}
+
 type httpClient struct {
 	config Config
 	client http.Client
+
+	etagMu sync.Mutex
+	etags  map[string]cachedResponse
 }
 
-func (c *httpClient) URL(ep string) *url.URL {
-	u, _ := c.config.ExperimentsURL(ep)
-	return u
+func (c *httpClient) URL(ep string) (*url.URL, error) {
+	return c.config.ExperimentsURL(ep)
 }
 
-func (c *httpClient) Do(ctx context.Context, req *http.Request) (*http.Response, []byte, error) {
-	if ctx != nil {
-		req = req.WithContext(ctx)
+func (c *httpClient) Do(ctx context.Context, req *http.Request) (*http.Response, io.ReadCloser, error) {
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept-Encoding", "gzip")
+
+	key := req.URL.String()
+	if req.Method == http.MethodGet {
+		c.etagMu.Lock()
+		if cached, ok := c.etags[key]; ok {
+			req.Header.Set("If-None-Match", cached.etag)
+		}
+		c.etagMu.Unlock()
 	}
-	resp, err := c.client.Do(req)
+
+	resp, err := c.doWithRetry(req)
 	if err != nil {
 		return nil, nil, err
 	}
-	defer resp.Body.Close()
 
-	var body []byte
-	done := make(chan struct{})
-	go func() {
-		body, err = ioutil.ReadAll(resp.Body)
-		close(done)
-	}()
+	if resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+		c.etagMu.Lock()
+		cached := c.etags[key]
+		c.etagMu.Unlock()
+		return resp, ioutil.NopCloser(bytes.NewReader(cached.body)), nil
+	}
 
-	select {
-	case <-ctx.Done():
-		<-done
-		err = resp.Body.Close()
-		if err == nil {
-			err = ctx.Err()
+	body := io.ReadCloser(resp.Body)
+	if resp.Header.Get("Content-Encoding") == "gzip" {
+		body, err = gzipReadCloser(ctx, resp.Body)
+		if err != nil {
+			resp.Body.Close()
+			return nil, nil, err
 		}
-	case <-done:
 	}
 
-	return resp, body, err
+	if req.Method == http.MethodGet {
+		if etag := resp.Header.Get("ETag"); etag != "" {
+			buf, err := ioutil.ReadAll(body)
+			body.Close()
+			if err != nil {
+				return nil, nil, err
+			}
+
+			c.etagMu.Lock()
+			if c.etags == nil {
+				c.etags = make(map[string]cachedResponse)
+			}
+			c.etags[key] = cachedResponse{etag: etag, body: buf}
+			c.etagMu.Unlock()
+
+			body = ioutil.NopCloser(bytes.NewReader(buf))
+		}
+	}
+
+	return resp, body, nil
+}
+
+func (c *httpClient) DoAndReadAll(ctx context.Context, req *http.Request) (*http.Response, []byte, error) {
+	resp, body, err := c.Do(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer body.Close()
+
+	buf, err := ioutil.ReadAll(body)
+	return resp, buf, err
+}
+
+// doWithRetry executes req, retrying on a 429/503 response up to maxRetries times with exponential backoff,
+// honoring a Retry-After header when the server sent one.
+func (c *httpClient) doWithRetry(req *http.Request) (*http.Response, error) {
+	var resp *http.Response
+	var err error
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			if req.GetBody != nil {
+				body, berr := req.GetBody()
+				if berr != nil {
+					return nil, berr
+				}
+				req.Body = body
+			}
+
+			select {
+			case <-req.Context().Done():
+				return nil, req.Context().Err()
+			case <-time.After(retryDelay(resp, attempt-1)):
+			}
+		}
+
+		resp, err = c.client.Do(req)
+		if err != nil || attempt == maxRetries || !isRetryableStatus(resp.StatusCode) {
+			return resp, err
+		}
+		resp.Body.Close()
+	}
+}
+
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code == http.StatusServiceUnavailable
+}
+
+// retryDelay honors a Retry-After header (either delay-seconds or an HTTP date), falling back to exponential
+// backoff with jitter when the server did not send one.
+func retryDelay(resp *http.Response, attempt int) time.Duration {
+	if ra := resp.Header.Get("Retry-After"); ra != "" {
+		if secs, err := strconv.Atoi(ra); err == nil {
+			return time.Duration(secs) * time.Second
+		}
+		if t, err := http.ParseTime(ra); err == nil {
+			return time.Until(t)
+		}
+	}
+
+	base := time.Duration(1<<uint(attempt)) * 100 * time.Millisecond
+	return base + time.Duration(rand.Int63n(int64(base)))
+}
+
+// gzipReadCloser transparently decompresses body, relaying it through an io.Pipe so that cancellation doesn't
+// race the caller's buffer: unlike a Read-by-Read ctx.Done() race, io.Pipe's Write only returns once a Read has
+// copied its data out, so closing the pipe on cancellation can never leave a goroutine writing into a buffer the
+// caller has already reclaimed. The uncompressed, non-gzip body is returned as-is; its Read already unblocks on
+// context cancellation via the request context that Do attaches with req.WithContext, carried through to resp.Body.
+func gzipReadCloser(ctx context.Context, body io.ReadCloser) (io.ReadCloser, error) {
+	gz, err := gzip.NewReader(body)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		_, copyErr := io.Copy(pw, gz)
+		gz.Close()
+		body.Close()
+		pw.CloseWithError(copyErr)
+	}()
+	go func() {
+		<-ctx.Done()
+		pr.CloseWithError(ctx.Err())
+	}()
+
+	return pr, nil
 }
@@ -0,0 +1,291 @@
+/*
+Copyright 2019 GramLabs, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package redskyapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIsRetryableStatus(t *testing.T) {
+	cases := []struct {
+		code int
+		want bool
+	}{
+		{http.StatusOK, false},
+		{http.StatusNotFound, false},
+		{http.StatusTooManyRequests, true},
+		{http.StatusServiceUnavailable, true},
+		{http.StatusInternalServerError, false},
+	}
+
+	for _, c := range cases {
+		if got := isRetryableStatus(c.code); got != c.want {
+			t.Errorf("isRetryableStatus(%d) = %v, want %v", c.code, got, c.want)
+		}
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	cases := []struct {
+		name    string
+		header  string
+		attempt int
+		want    time.Duration
+	}{
+		{name: "delay seconds", header: "2", attempt: 0, want: 2 * time.Second},
+		{name: "http date", header: time.Now().Add(3 * time.Second).UTC().Format(http.TimeFormat), attempt: 0, want: 3 * time.Second},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			resp.Header.Set("Retry-After", c.header)
+			got := retryDelay(resp, c.attempt)
+			if diff := got - c.want; diff < -time.Second || diff > time.Second {
+				t.Errorf("retryDelay() = %v, want approximately %v", got, c.want)
+			}
+		})
+	}
+
+	t.Run("falls back to exponential backoff with jitter", func(t *testing.T) {
+		resp := &http.Response{Header: http.Header{}}
+		d0 := retryDelay(resp, 0)
+		d1 := retryDelay(resp, 1)
+		if d0 < 100*time.Millisecond || d0 >= 200*time.Millisecond {
+			t.Errorf("retryDelay(attempt=0) = %v, want in [100ms, 200ms)", d0)
+		}
+		if d1 < 200*time.Millisecond || d1 >= 400*time.Millisecond {
+			t.Errorf("retryDelay(attempt=1) = %v, want in [200ms, 400ms)", d1)
+		}
+	})
+}
+
+func TestGzipReadCloser(t *testing.T) {
+	var gzipped bytes.Buffer
+	gz := gzip.NewWriter(&gzipped)
+	_, _ = gz.Write([]byte("hello"))
+	_ = gz.Close()
+
+	body, err := gzipReadCloser(context.Background(), ioutil.NopCloser(bytes.NewReader(gzipped.Bytes())))
+	if err != nil {
+		t.Fatalf("gzipReadCloser() error = %v", err)
+	}
+	defer body.Close()
+
+	buf, err := ioutil.ReadAll(body)
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	if string(buf) != "hello" {
+		t.Errorf("gzipReadCloser() = %q, want %q", buf, "hello")
+	}
+}
+
+func TestRoundTripperChainThen(t *testing.T) {
+	var order []string
+	middleware := func(name string) func(http.RoundTripper) http.RoundTripper {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				order = append(order, name)
+				return next.RoundTrip(req)
+			})
+		}
+	}
+
+	base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		order = append(order, "base")
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	})
+
+	chain := RoundTripperChain{middleware("outer"), middleware("inner")}
+	rt := chain.Then(base)
+
+	req := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	if _, err := rt.RoundTrip(req); err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+
+	want := []string{"outer", "inner", "base"}
+	if len(order) != len(want) {
+		t.Fatalf("call order = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Errorf("call order = %v, want %v", order, want)
+			break
+		}
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestHTTPClientDoRetriesOnServiceUnavailable(t *testing.T) {
+	var attempts int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	c := &httpClient{}
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	resp, body, err := c.DoAndReadAll(context.Background(), req)
+	if err != nil {
+		t.Fatalf("DoAndReadAll() error = %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("StatusCode = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if string(body) != "ok" {
+		t.Errorf("body = %q, want %q", body, "ok")
+	}
+	if attempts != 3 {
+		t.Errorf("attempts = %d, want 3", attempts)
+	}
+}
+
+func TestHTTPClientDoETagCaching(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte("body-v1"))
+	}))
+	defer srv.Close()
+
+	c := &httpClient{}
+
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	_, firstBody, err := c.DoAndReadAll(context.Background(), req)
+	if err != nil {
+		t.Fatalf("DoAndReadAll() error = %v", err)
+	}
+	if string(firstBody) != "body-v1" {
+		t.Fatalf("first body = %q, want %q", firstBody, "body-v1")
+	}
+
+	req2, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	resp2, secondBody, err := c.DoAndReadAll(context.Background(), req2)
+	if err != nil {
+		t.Fatalf("DoAndReadAll() error = %v", err)
+	}
+	if resp2.StatusCode != http.StatusNotModified {
+		t.Errorf("second StatusCode = %d, want %d", resp2.StatusCode, http.StatusNotModified)
+	}
+	if string(secondBody) != "body-v1" {
+		t.Errorf("second body = %q, want cached %q", secondBody, "body-v1")
+	}
+	if requests != 2 {
+		t.Errorf("requests = %d, want 2", requests)
+	}
+}
+
+func TestHTTPClientDoGzipDecoding(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			t.Errorf("Accept-Encoding = %q, want %q", r.Header.Get("Accept-Encoding"), "gzip")
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		_, _ = gz.Write([]byte("compressed"))
+		_ = gz.Close()
+	}))
+	defer srv.Close()
+
+	c := &httpClient{}
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	_, body, err := c.DoAndReadAll(context.Background(), req)
+	if err != nil {
+		t.Fatalf("DoAndReadAll() error = %v", err)
+	}
+	if string(body) != "compressed" {
+		t.Errorf("body = %q, want %q", body, "compressed")
+	}
+}
+
+// TestHTTPClientDoGzipCancelDuringRead exercises context cancellation mid-stream on the gzip decoding path;
+// run with -race, it confirms gzipReadCloser never leaves a goroutine writing into a buffer the caller has
+// already reclaimed (the bug a prior, Read-by-Read ctx.Done() race reintroduced).
+func TestHTTPClientDoGzipCancelDuringRead(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		_, _ = gz.Write([]byte("first-chunk-"))
+		_ = gz.Flush()
+		if f, ok := w.(http.Flusher); ok {
+			f.Flush()
+		}
+		<-release
+		_, _ = gz.Write([]byte("second-chunk"))
+		_ = gz.Close()
+	}))
+	defer func() {
+		close(release)
+		srv.Close()
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c := &httpClient{}
+	req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+	_, body, err := c.Do(ctx, req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	defer body.Close()
+
+	buf := make([]byte, 4)
+	if _, err := body.Read(buf); err != nil {
+		t.Fatalf("first Read() error = %v", err)
+	}
+
+	cancel()
+
+	readErr := make(chan error, 1)
+	go func() {
+		_, err := body.Read(buf)
+		readErr <- err
+	}()
+
+	select {
+	case err := <-readErr:
+		if err == nil {
+			t.Errorf("Read() after cancel = nil error, want non-nil")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Read() after context cancellation did not return")
+	}
+}